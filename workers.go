@@ -10,6 +10,7 @@ import (
 	"mime/multipart"
 	"net/http"
 	"net/textproto"
+	"net/url"
 	"strings"
 	"time"
 
@@ -63,6 +64,15 @@ type CreateWorkerParams struct {
 	// Tags are used to better manage CRUD operations at scale.
 	//  https://developers.cloudflare.com/cloudflare-for-platforms/workers-for-platforms/platform/tags/
 	Tags []string
+
+	// Annotations are free-form key/value metadata attached to the upload,
+	// e.g. a commit hash or release note. Used by CreateWorkerVersion.
+	Annotations map[string]string
+
+	// Assets, when set, uploads a bundle of static assets alongside the
+	// script so it can serve a Workers Static Assets site.
+	//  https://developers.cloudflare.com/workers/static-assets/
+	Assets *WorkerAssets
 }
 
 func (p CreateWorkerParams) RequiresMultipart() bool {
@@ -83,6 +93,8 @@ func (p CreateWorkerParams) RequiresMultipart() bool {
 		return true
 	case len(p.Tags) > 0:
 		return true
+	case p.Assets != nil:
+		return true
 	}
 
 	return false
@@ -129,6 +141,10 @@ type UpdateWorkersScriptSettingsParams struct {
 	CompatibilityFlags []string
 
 	Placement *Placement
+
+	// Tags are used to better manage CRUD operations at scale.
+	//  https://developers.cloudflare.com/cloudflare-for-platforms/workers-for-platforms/platform/tags/
+	Tags []string `json:"tags,omitempty"`
 }
 
 // WorkerScriptParams provides a worker script and the associated bindings.
@@ -203,7 +219,10 @@ type WorkerListResponse struct {
 // WorkerScriptResponse wrapper struct for API response to worker script calls.
 type WorkerScriptResponse struct {
 	Response
-	Module       bool
+	Module bool
+	// AssetsJWT is the completion token returned by the Workers Static
+	// Assets upload session when the script was created with Assets set.
+	AssetsJWT    string `json:"-"`
 	WorkerScript `json:"result"`
 }
 
@@ -213,7 +232,11 @@ type WorkerScriptSettingsResponse struct {
 	WorkerMetaData
 }
 
-type ListWorkersParams struct{}
+type ListWorkersParams struct {
+	// Tags narrows the listing down to scripts matching the given tag
+	// filters, built with TagFiltersFromMap or constructed directly.
+	Tags []TagFilter
+}
 
 type DeleteWorkerParams struct {
 	ScriptName string
@@ -315,6 +338,12 @@ func (api *API) ListWorkers(ctx context.Context, rc *ResourceContainer, params L
 	}
 
 	uri := fmt.Sprintf("/accounts/%s/workers/scripts", rc.Identifier)
+	if len(params.Tags) > 0 {
+		v := url.Values{}
+		v.Set("tags", tagFiltersQuery(params.Tags))
+		uri = uri + "?" + v.Encode()
+	}
+
 	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
 	if err != nil {
 		return WorkerListResponse{}, &ResultInfo{}, err
@@ -345,6 +374,7 @@ func (api *API) UploadWorker(ctx context.Context, rc *ResourceContainer, params
 		contentType = "application/javascript"
 		err         error
 		body        interface{}
+		assetsJWT   string
 	)
 	mpChan := make(chan error)
 	if params.RequiresMultipart() {
@@ -354,7 +384,7 @@ func (api *API) UploadWorker(ctx context.Context, rc *ResourceContainer, params
 		body = r
 		go func() {
 			defer w.Close()
-			_, _, err = formatMultipartBody(params, mpw)
+			_, _, assetsJWT, err = formatMultipartBody(ctx, api, rc, params, mpw)
 			if err != nil {
 				mpChan <- err
 			}
@@ -396,6 +426,7 @@ func (api *API) UploadWorker(ctx context.Context, rc *ResourceContainer, params
 	if err != nil {
 		return WorkerScriptResponse{}, err
 	}
+	r.AssetsJWT = assetsJWT
 	return *r, nil
 }
 
@@ -450,7 +481,7 @@ func (api *API) UpdateWorkersScriptContent(ctx context.Context, rc *ResourceCont
 		body = r
 		go func() {
 			defer w.Close()
-			_, _, err = formatMultipartBody(formattedParams, mpw)
+			_, _, _, err = formatMultipartBody(ctx, api, rc, formattedParams, mpw)
 			if err != nil {
 				mpChan <- err
 			}
@@ -562,8 +593,9 @@ func (api *API) UpdateWorkersScriptSettings(ctx context.Context, rc *ResourceCon
 	return r, nil
 }
 
-// Returns content-type, body, error.
-func formatMultipartBody(params CreateWorkerParams, mpw *multipart.Writer) (string, textproto.MIMEHeader, error) {
+// Returns content-type, body, the Workers Static Assets completion token (if
+// any), and error.
+func formatMultipartBody(ctx context.Context, api *API, rc *ResourceContainer, params CreateWorkerParams, mpw *multipart.Writer) (string, textproto.MIMEHeader, string, error) {
 	defer mpw.Close()
 	// Write metadata part
 	var scriptPartName string
@@ -577,12 +609,15 @@ func formatMultipartBody(params CreateWorkerParams, mpw *multipart.Writer) (stri
 		CompatibilityFlags []string               `json:"compatibility_flags,omitempty"`
 		Placement          *Placement             `json:"placement,omitempty"`
 		Tags               []string               `json:"tags"`
+		Annotations        map[string]string      `json:"annotations,omitempty"`
+		Assets             *workerAssetsMeta      `json:"assets,omitempty"`
 	}{
 		Bindings:           make([]workerBindingMeta, 0, len(params.Bindings)),
 		Logpush:            params.Logpush,
 		TailConsumers:      params.TailConsumers,
 		CompatibilityDate:  params.CompatibilityDate,
 		CompatibilityFlags: params.CompatibilityFlags,
+		Annotations:        params.Annotations,
 		Placement:          params.Placement,
 		Tags:               params.Tags,
 	}
@@ -595,11 +630,19 @@ func formatMultipartBody(params CreateWorkerParams, mpw *multipart.Writer) (stri
 		meta.BodyPart = scriptPartName
 	}
 
+	if params.Assets != nil {
+		jwt, err := api.uploadWorkerAssets(ctx, rc, params.Assets)
+		if err != nil {
+			return "", nil, "", fmt.Errorf("could not upload worker assets: %w", err)
+		}
+		meta.Assets = &workerAssetsMeta{JWT: jwt, Config: params.Assets.Config}
+	}
+
 	bodyWriters := make([]workerBindingBodyWriter, 0, len(params.Bindings))
 	for name, b := range params.Bindings {
 		bindingMeta, bodyWriter, err := b.serialize(name)
 		if err != nil {
-			return "", nil, err
+			return "", nil, "", err
 		}
 
 		meta.Bindings = append(meta.Bindings, bindingMeta)
@@ -610,15 +653,15 @@ func formatMultipartBody(params CreateWorkerParams, mpw *multipart.Writer) (stri
 	hdr.Set("content-type", "application/json")
 	pw, err := mpw.CreatePart(hdr)
 	if err != nil {
-		return "", nil, err
+		return "", nil, "", err
 	}
 	metaJSON, err := json.Marshal(meta)
 	if err != nil {
-		return "", nil, err
+		return "", nil, "", err
 	}
 	_, err = pw.Write(metaJSON)
 	if err != nil {
-		return "", nil, err
+		return "", nil, "", err
 	}
 
 	// Write script part
@@ -635,7 +678,7 @@ func formatMultipartBody(params CreateWorkerParams, mpw *multipart.Writer) (stri
 
 	pw, err = mpw.CreatePart(hdr)
 	if err != nil {
-		return "", nil, err
+		return "", nil, "", err
 	}
 	if val, ok := params.Script.(io.Reader); ok {
 		_, err = io.Copy(pw, val)
@@ -644,11 +687,11 @@ func formatMultipartBody(params CreateWorkerParams, mpw *multipart.Writer) (stri
 		case string:
 			pw.Write([]byte(val))
 		default:
-			return "", nil, errors.New("Failed to read script")
+			return "", nil, "", errors.New("Failed to read script")
 		}
 	}
 	if err != nil {
-		return "", nil, err
+		return "", nil, "", err
 	}
 
 	// Write other bindings with parts
@@ -656,10 +699,15 @@ func formatMultipartBody(params CreateWorkerParams, mpw *multipart.Writer) (stri
 		if w != nil {
 			err = w(mpw)
 			if err != nil {
-				return "", nil, err
+				return "", nil, "", err
 			}
 		}
 	}
 
-	return mpw.FormDataContentType(), hdr, nil
+	var assetsJWT string
+	if meta.Assets != nil {
+		assetsJWT = meta.Assets.JWT
+	}
+
+	return mpw.FormDataContentType(), hdr, assetsJWT, nil
 }
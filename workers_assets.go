@@ -0,0 +1,296 @@
+package cloudflare
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/goccy/go-json"
+)
+
+// maxAssetUploadBatchBytes is the largest base64-encoded payload the
+// assets-upload-session endpoint accepts per batch.
+const maxAssetUploadBatchBytes = 50 * 1024 * 1024
+
+// WorkerAssetsConfig controls how a Workers Static Assets upload is served.
+//
+// API reference: https://developers.cloudflare.com/workers/static-assets/
+type WorkerAssetsConfig struct {
+	// HTMLHandling controls automatic trimming/redirecting of the .html
+	// extension from requests, e.g. "auto-trailing-slash".
+	HTMLHandling string `json:"html_handling,omitempty"`
+
+	// NotFoundHandling controls what is served when no asset matches a
+	// request, e.g. "single-page-application" or "404-page".
+	NotFoundHandling string `json:"not_found_handling,omitempty"`
+
+	// ServeSinglePageApp serves the root index.html for unmatched requests,
+	// making it easy to host a single-page application.
+	ServeSinglePageApp bool `json:"serve_single_page_app,omitempty"`
+}
+
+// WorkerAssets is a bundle of static assets uploaded alongside a Worker
+// script, sourced from either Directory (walked from disk) or Files (an
+// in-memory map of path to content). Set exactly one of the two.
+type WorkerAssets struct {
+	// Directory is a path to a directory of static assets to upload.
+	Directory string
+
+	// Files is an in-memory map of asset path to file content. Takes
+	// precedence over Directory when both are set.
+	Files map[string][]byte
+
+	Config WorkerAssetsConfig
+}
+
+// workerAssetsMeta is the `assets` object embedded in the script multipart
+// metadata part once the manifest has been uploaded.
+type workerAssetsMeta struct {
+	JWT    string             `json:"jwt"`
+	Config WorkerAssetsConfig `json:"config,omitempty"`
+}
+
+type workerAssetManifestEntry struct {
+	Hash string `json:"hash"`
+	Size int    `json:"size"`
+}
+
+type workerAssetsUploadSessionParams struct {
+	Manifest map[string]workerAssetManifestEntry `json:"manifest"`
+}
+
+type workerAssetsUploadSessionResponse struct {
+	Response
+	Result struct {
+		JWT     string     `json:"jwt"`
+		Buckets [][]string `json:"buckets"`
+	} `json:"result"`
+}
+
+type workerAssetsUploadResponse struct {
+	Response
+	Result struct {
+		JWT string `json:"jwt"`
+	} `json:"result"`
+}
+
+// assetHash derives the manifest hash for a single asset the same way
+// wrangler does: SHA-256 of the content followed by the file extension
+// (without the leading dot), truncated to 32 hex characters.
+func assetHash(path string, content []byte) string {
+	ext := strings.TrimPrefix(filepath.Ext(path), ".")
+
+	h := sha256.New()
+	h.Write(content)
+	h.Write([]byte(ext))
+
+	return hex.EncodeToString(h.Sum(nil))[:32]
+}
+
+// files resolves the asset bundle to a path -> content map, preferring the
+// in-memory Files map over walking Directory.
+func (a *WorkerAssets) files() (map[string][]byte, error) {
+	if a.Files != nil {
+		return a.Files, nil
+	}
+
+	if a.Directory == "" {
+		return nil, errors.New("cloudflare: WorkerAssets requires either Files or Directory to be set")
+	}
+
+	files := make(map[string][]byte)
+	err := filepath.WalkDir(a.Directory, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(a.Directory, path)
+		if err != nil {
+			return err
+		}
+		files["/"+filepath.ToSlash(rel)] = content
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return files, nil
+}
+
+// uploadWorkerAssets runs the two-phase Workers Static Assets upload: it
+// submits a manifest of per-file SHA-256 hashes and sizes, then uploads the
+// contents of whichever files the server reports missing, returning the JWT
+// to embed in the script's multipart metadata.
+func (api *API) uploadWorkerAssets(ctx context.Context, rc *ResourceContainer, assets *WorkerAssets) (string, error) {
+	files, err := assets.files()
+	if err != nil {
+		return "", err
+	}
+
+	manifest := make(map[string]workerAssetManifestEntry, len(files))
+	pathByHash := make(map[string]string, len(files))
+	for path, content := range files {
+		hash := assetHash(path, content)
+		manifest[path] = workerAssetManifestEntry{Hash: hash, Size: len(content)}
+		pathByHash[hash] = path
+	}
+
+	body, err := json.Marshal(workerAssetsUploadSessionParams{Manifest: manifest})
+	if err != nil {
+		return "", err
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/workers/assets-upload-session", rc.Identifier)
+	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, body)
+	if err != nil {
+		return "", err
+	}
+
+	var session workerAssetsUploadSessionResponse
+	if err := json.Unmarshal(res, &session); err != nil {
+		return "", fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	jwt := session.Result.JWT
+	for _, bucket := range session.Result.Buckets {
+		jwt, err = api.uploadWorkerAssetsBatch(ctx, rc, jwt, bucket, files, pathByHash)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return jwt, nil
+}
+
+// uploadWorkerAssetsBatch base64-encodes the contents for a bucket of
+// missing file hashes and uploads them under the session JWT, splitting the
+// bucket into multiple requests if it would otherwise exceed the ~50 MiB
+// per-batch limit.
+func (api *API) uploadWorkerAssetsBatch(ctx context.Context, rc *ResourceContainer, jwt string, hashes []string, files map[string][]byte, pathByHash map[string]string) (string, error) {
+	for start := 0; start < len(hashes); {
+		end := start
+		size := 0
+		for end < len(hashes) {
+			path, ok := pathByHash[hashes[end]]
+			if !ok {
+				end++
+				continue
+			}
+			encodedLen := base64.StdEncoding.EncodedLen(len(files[path]))
+			if end > start && size+encodedLen > maxAssetUploadBatchBytes {
+				break
+			}
+			size += encodedLen
+			end++
+		}
+
+		var err error
+		jwt, err = api.postWorkerAssetsBatch(ctx, rc, jwt, hashes[start:end], files, pathByHash)
+		if err != nil {
+			return "", err
+		}
+		start = end
+	}
+
+	return jwt, nil
+}
+
+// postWorkerAssetsBatch uploads a single request's worth of base64-encoded
+// file contents under the session JWT.
+func (api *API) postWorkerAssetsBatch(ctx context.Context, rc *ResourceContainer, jwt string, hashes []string, files map[string][]byte, pathByHash map[string]string) (string, error) {
+	var buf bytes.Buffer
+	mpw := multipart.NewWriter(&buf)
+
+	for _, hash := range hashes {
+		path, ok := pathByHash[hash]
+		if !ok {
+			continue
+		}
+		encoded := base64.StdEncoding.EncodeToString(files[path])
+
+		hdr := textproto.MIMEHeader{}
+		hdr.Set("content-disposition", fmt.Sprintf(`form-data; name="%s"; filename="%[1]s"`, hash))
+		hdr.Set("content-type", "application/null")
+		hdr.Set("content-transfer-encoding", "base64")
+		pw, err := mpw.CreatePart(hdr)
+		if err != nil {
+			return "", err
+		}
+		if _, err := pw.Write([]byte(encoded)); err != nil {
+			return "", err
+		}
+	}
+	if err := mpw.Close(); err != nil {
+		return "", err
+	}
+
+	// The upload session JWT, not the account's own credentials, authorizes
+	// this request, so it goes out through a bare request instead of
+	// makeRequestContextWithHeaders: that helper always applies the
+	// account's Authorization header, which would shadow the JWT.
+	uri := fmt.Sprintf("/accounts/%s/workers/assets/upload?base64=true", rc.Identifier)
+	res, err := api.postWorkerAssetsBatchRequest(ctx, uri, buf.Bytes(), mpw.FormDataContentType(), jwt)
+	if err != nil {
+		return "", err
+	}
+
+	var uploadResp workerAssetsUploadResponse
+	if err := json.Unmarshal(res, &uploadResp); err != nil {
+		return "", fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+	if uploadResp.Result.JWT != "" {
+		return uploadResp.Result.JWT, nil
+	}
+
+	return jwt, nil
+}
+
+// postWorkerAssetsBatchRequest issues the batch upload directly against the
+// API's own HTTP client and base URL, authorized with the upload session
+// JWT instead of the account's own credentials.
+func (api *API) postWorkerAssetsBatchRequest(ctx context.Context, uri string, body []byte, contentType, jwt string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, api.BaseURL+uri, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("Authorization", "Bearer "+jwt)
+
+	resp, err := api.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		return nil, fmt.Errorf("worker assets upload failed: %s: %s", resp.Status, respBody)
+	}
+
+	return respBody, nil
+}
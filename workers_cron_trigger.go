@@ -0,0 +1,91 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/goccy/go-json"
+)
+
+// WorkerCronTrigger is a single cron schedule attached to a worker script.
+//
+// API reference: https://developers.cloudflare.com/workers/configuration/cron-triggers/
+type WorkerCronTrigger struct {
+	Cron string `json:"cron"`
+}
+
+// WorkerCronTriggerResponse wrapper struct for API response to the worker
+// cron trigger calls.
+type WorkerCronTriggerResponse struct {
+	Response
+	Result struct {
+		Schedules []WorkerCronTrigger `json:"schedules"`
+	} `json:"result"`
+}
+
+// UpdateWorkerCronTriggersParams provides the parameters for
+// UpdateWorkerCronTriggers.
+type UpdateWorkerCronTriggersParams struct {
+	ScriptName string
+	Crons      []WorkerCronTrigger
+}
+
+// ListWorkerCronTriggers fetches all cron triggers for a single worker
+// script.
+//
+// API reference: https://developers.cloudflare.com/api/operations/worker-cron-trigger-get-cron-triggers
+func (api *API) ListWorkerCronTriggers(ctx context.Context, rc *ResourceContainer, scriptName string) ([]WorkerCronTrigger, error) {
+	if rc.Level != AccountRouteLevel {
+		return nil, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return nil, ErrMissingAccountID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/workers/scripts/%s/schedules", rc.Identifier, scriptName)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var r WorkerCronTriggerResponse
+	if err := json.Unmarshal(res, &r); err != nil {
+		return nil, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result.Schedules, nil
+}
+
+// UpdateWorkerCronTriggers replaces all cron triggers for a single worker
+// script.
+//
+// API reference: https://developers.cloudflare.com/api/operations/worker-cron-trigger-update-cron-triggers
+func (api *API) UpdateWorkerCronTriggers(ctx context.Context, rc *ResourceContainer, params UpdateWorkerCronTriggersParams) ([]WorkerCronTrigger, error) {
+	if rc.Level != AccountRouteLevel {
+		return nil, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return nil, ErrMissingAccountID
+	}
+
+	body, err := json.Marshal(params.Crons)
+	if err != nil {
+		return nil, err
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/workers/scripts/%s/schedules", rc.Identifier, params.ScriptName)
+	res, err := api.makeRequestContext(ctx, http.MethodPut, uri, body)
+	if err != nil {
+		return nil, err
+	}
+
+	var r WorkerCronTriggerResponse
+	if err := json.Unmarshal(res, &r); err != nil {
+		return nil, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result.Schedules, nil
+}
@@ -0,0 +1,224 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/goccy/go-json"
+)
+
+// WorkersDispatchNamespace is a Workers for Platforms dispatch namespace,
+// the container scripts are uploaded into when using
+// CreateWorkerParams.DispatchNamespaceName.
+type WorkersDispatchNamespace struct {
+	NamespaceID   string    `json:"namespace_id,omitempty"`
+	NamespaceName string    `json:"namespace_name,omitempty"`
+	CreatedOn     time.Time `json:"created_on,omitempty"`
+	CreatedBy     string    `json:"created_by,omitempty"`
+	ModifiedOn    time.Time `json:"modified_on,omitempty"`
+	ModifiedBy    string    `json:"modified_by,omitempty"`
+}
+
+// WorkersDispatchNamespaceResponse wrapper struct for API response to a
+// single dispatch namespace call.
+type WorkersDispatchNamespaceResponse struct {
+	Response
+	Result WorkersDispatchNamespace `json:"result"`
+}
+
+// WorkersDispatchNamespaceListResponse wrapper struct for API response to
+// the dispatch namespace list call.
+type WorkersDispatchNamespaceListResponse struct {
+	Response
+	ResultInfo
+	Result []WorkersDispatchNamespace `json:"result"`
+}
+
+// CreateWorkersDispatchNamespaceParams provides the parameters for
+// CreateDispatchNamespace.
+type CreateWorkersDispatchNamespaceParams struct {
+	Name string `json:"name"`
+}
+
+// TagFilter narrows a worker script listing down to scripts that do, or do
+// not, carry a given tag.
+//
+// API reference: https://developers.cloudflare.com/cloudflare-for-platforms/workers-for-platforms/platform/tags/
+type TagFilter struct {
+	Tag     string
+	Include bool
+}
+
+// TagFiltersFromMap builds a slice of TagFilter from a map of tag name to
+// whether scripts carrying it should be included (true) or excluded (false).
+func TagFiltersFromMap(tags map[string]bool) []TagFilter {
+	filters := make([]TagFilter, 0, len(tags))
+	for tag, include := range tags {
+		filters = append(filters, TagFilter{Tag: tag, Include: include})
+	}
+	return filters
+}
+
+// encode renders the tag filters in the `mytag:yes`/`mytag:no` form expected
+// by the `tags` querystring parameter.
+func (f TagFilter) encode() string {
+	state := "yes"
+	if !f.Include {
+		state = "no"
+	}
+	return fmt.Sprintf("%s:%s", f.Tag, state)
+}
+
+func tagFiltersQuery(tags []TagFilter) string {
+	parts := make([]string, 0, len(tags))
+	for _, t := range tags {
+		parts = append(parts, t.encode())
+	}
+	return strings.Join(parts, ",")
+}
+
+// CreateDispatchNamespace creates a new Workers for Platforms dispatch
+// namespace.
+//
+// API reference: https://developers.cloudflare.com/api/operations/workers-for-platforms-dispatch-namespace-create-a-dispatch-namespace
+func (api *API) CreateDispatchNamespace(ctx context.Context, rc *ResourceContainer, params CreateWorkersDispatchNamespaceParams) (WorkersDispatchNamespaceResponse, error) {
+	if rc.Level != AccountRouteLevel {
+		return WorkersDispatchNamespaceResponse{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return WorkersDispatchNamespaceResponse{}, ErrMissingAccountID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/workers/dispatch/namespaces", rc.Identifier)
+	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, params)
+	if err != nil {
+		return WorkersDispatchNamespaceResponse{}, err
+	}
+
+	var r WorkersDispatchNamespaceResponse
+	if err := json.Unmarshal(res, &r); err != nil {
+		return WorkersDispatchNamespaceResponse{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r, nil
+}
+
+// GetDispatchNamespace returns a single Workers for Platforms dispatch
+// namespace.
+//
+// API reference: https://developers.cloudflare.com/api/operations/workers-for-platforms-dispatch-namespace-get-a-dispatch-namespace
+func (api *API) GetDispatchNamespace(ctx context.Context, rc *ResourceContainer, name string) (WorkersDispatchNamespaceResponse, error) {
+	if rc.Level != AccountRouteLevel {
+		return WorkersDispatchNamespaceResponse{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return WorkersDispatchNamespaceResponse{}, ErrMissingAccountID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/workers/dispatch/namespaces/%s", rc.Identifier, name)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return WorkersDispatchNamespaceResponse{}, err
+	}
+
+	var r WorkersDispatchNamespaceResponse
+	if err := json.Unmarshal(res, &r); err != nil {
+		return WorkersDispatchNamespaceResponse{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r, nil
+}
+
+// ListDispatchNamespaces returns all Workers for Platforms dispatch
+// namespaces for the account.
+//
+// API reference: https://developers.cloudflare.com/api/operations/workers-for-platforms-dispatch-namespace-list-dispatch-namespaces
+func (api *API) ListDispatchNamespaces(ctx context.Context, rc *ResourceContainer) (WorkersDispatchNamespaceListResponse, error) {
+	if rc.Level != AccountRouteLevel {
+		return WorkersDispatchNamespaceListResponse{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return WorkersDispatchNamespaceListResponse{}, ErrMissingAccountID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/workers/dispatch/namespaces", rc.Identifier)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return WorkersDispatchNamespaceListResponse{}, err
+	}
+
+	var r WorkersDispatchNamespaceListResponse
+	if err := json.Unmarshal(res, &r); err != nil {
+		return WorkersDispatchNamespaceListResponse{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r, nil
+}
+
+// DeleteDispatchNamespace deletes a Workers for Platforms dispatch
+// namespace.
+//
+// API reference: https://developers.cloudflare.com/api/operations/workers-for-platforms-dispatch-namespace-delete-a-dispatch-namespace
+func (api *API) DeleteDispatchNamespace(ctx context.Context, rc *ResourceContainer, name string) error {
+	if rc.Level != AccountRouteLevel {
+		return ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return ErrMissingAccountID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/workers/dispatch/namespaces/%s", rc.Identifier, name)
+	res, err := api.makeRequestContext(ctx, http.MethodDelete, uri, nil)
+	if err != nil {
+		return err
+	}
+
+	var r WorkersDispatchNamespaceResponse
+	if err := json.Unmarshal(res, &r); err != nil {
+		return fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return nil
+}
+
+// ListWorkersForDispatchNamespace returns the scripts uploaded into a
+// Workers for Platforms dispatch namespace, optionally narrowed down with
+// ListWorkersParams.Tags.
+//
+// API reference: https://developers.cloudflare.com/api/operations/workers-for-platforms-dispatch-namespace-list-scripts
+func (api *API) ListWorkersForDispatchNamespace(ctx context.Context, rc *ResourceContainer, dispatchNamespace string, params ListWorkersParams) (WorkerListResponse, *ResultInfo, error) {
+	if rc.Level != AccountRouteLevel {
+		return WorkerListResponse{}, &ResultInfo{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return WorkerListResponse{}, &ResultInfo{}, ErrMissingAccountID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/workers/dispatch/namespaces/%s/scripts", rc.Identifier, dispatchNamespace)
+	if len(params.Tags) > 0 {
+		v := url.Values{}
+		v.Set("tags", tagFiltersQuery(params.Tags))
+		uri = uri + "?" + v.Encode()
+	}
+
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return WorkerListResponse{}, &ResultInfo{}, err
+	}
+
+	var r WorkerListResponse
+	if err := json.Unmarshal(res, &r); err != nil {
+		return WorkerListResponse{}, &ResultInfo{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r, &r.ResultInfo, nil
+}
@@ -0,0 +1,95 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/goccy/go-json"
+)
+
+// WorkerTagsResponse wrapper struct for API response to worker tag calls.
+type WorkerTagsResponse struct {
+	Response
+	Result []string `json:"result"`
+}
+
+// ListWorkerTags returns the tags currently set on a worker script.
+//
+// API reference: https://developers.cloudflare.com/api/operations/worker-script-tags-list-tags
+func (api *API) ListWorkerTags(ctx context.Context, rc *ResourceContainer, scriptName string) ([]string, error) {
+	if rc.Level != AccountRouteLevel {
+		return nil, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return nil, ErrMissingAccountID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/workers/scripts/%s/tags", rc.Identifier, scriptName)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var r WorkerTagsResponse
+	if err := json.Unmarshal(res, &r); err != nil {
+		return nil, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result, nil
+}
+
+// AddWorkerTag adds a single tag to a worker script, without re-uploading
+// the script, and returns the resulting set of tags.
+//
+// API reference: https://developers.cloudflare.com/api/operations/worker-script-tags-put-tag
+func (api *API) AddWorkerTag(ctx context.Context, rc *ResourceContainer, scriptName, tag string) ([]string, error) {
+	if rc.Level != AccountRouteLevel {
+		return nil, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return nil, ErrMissingAccountID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/workers/scripts/%s/tags/%s", rc.Identifier, scriptName, tag)
+	res, err := api.makeRequestContext(ctx, http.MethodPut, uri, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var r WorkerTagsResponse
+	if err := json.Unmarshal(res, &r); err != nil {
+		return nil, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result, nil
+}
+
+// DeleteWorkerTag removes a single tag from a worker script, without
+// re-uploading the script.
+//
+// API reference: https://developers.cloudflare.com/api/operations/worker-script-tags-delete-tag
+func (api *API) DeleteWorkerTag(ctx context.Context, rc *ResourceContainer, scriptName, tag string) error {
+	if rc.Level != AccountRouteLevel {
+		return ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return ErrMissingAccountID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/workers/scripts/%s/tags/%s", rc.Identifier, scriptName, tag)
+	res, err := api.makeRequestContext(ctx, http.MethodDelete, uri, nil)
+	if err != nil {
+		return err
+	}
+
+	var r WorkerTagsResponse
+	if err := json.Unmarshal(res, &r); err != nil {
+		return fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,215 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/goccy/go-json"
+)
+
+// WorkerTail is a live-log tail session created against a worker script.
+type WorkerTail struct {
+	ID        string    `json:"id"`
+	URL       string    `json:"url"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// WorkerTailResponse wrapper struct for API response to a single worker
+// tail call.
+type WorkerTailResponse struct {
+	Response
+	Result WorkerTail `json:"result"`
+}
+
+// WorkerTailListResponse wrapper struct for API response to the worker tail
+// list call.
+type WorkerTailListResponse struct {
+	Response
+	Result []WorkerTail `json:"result"`
+}
+
+// tailCreateParams is the envelope the start-tail endpoint expects: filters
+// are applied server-side to the whole session, not per websocket frame.
+type tailCreateParams struct {
+	Filters []TailFilters `json:"filters,omitempty"`
+	Debug   bool          `json:"debug,omitempty"`
+}
+
+// CreateWorkerTail starts a new live-log tail session for a worker script,
+// scoped to the given filters. The returned WorkerTail.URL is a websocket
+// endpoint that StreamWorkerTail knows how to consume directly.
+//
+// API reference: https://developers.cloudflare.com/api/operations/worker-tail-logs-start-tail
+func (api *API) CreateWorkerTail(ctx context.Context, rc *ResourceContainer, scriptName string, filters TailFilters) (WorkerTail, error) {
+	if rc.Level != AccountRouteLevel {
+		return WorkerTail{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return WorkerTail{}, ErrMissingAccountID
+	}
+
+	body, err := json.Marshal(tailCreateParams{Filters: []TailFilters{filters}})
+	if err != nil {
+		return WorkerTail{}, err
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/workers/scripts/%s/tails", rc.Identifier, scriptName)
+	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, body)
+	if err != nil {
+		return WorkerTail{}, err
+	}
+
+	var r WorkerTailResponse
+	if err := json.Unmarshal(res, &r); err != nil {
+		return WorkerTail{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result, nil
+}
+
+// ListWorkerTails returns the active tail sessions for a worker script.
+//
+// API reference: https://developers.cloudflare.com/api/operations/worker-tail-logs-list-tails
+func (api *API) ListWorkerTails(ctx context.Context, rc *ResourceContainer, scriptName string) ([]WorkerTail, error) {
+	if rc.Level != AccountRouteLevel {
+		return nil, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return nil, ErrMissingAccountID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/workers/scripts/%s/tails", rc.Identifier, scriptName)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var r WorkerTailListResponse
+	if err := json.Unmarshal(res, &r); err != nil {
+		return nil, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result, nil
+}
+
+// DeleteWorkerTail ends a tail session for a worker script.
+//
+// API reference: https://developers.cloudflare.com/api/operations/worker-tail-logs-delete-tail
+func (api *API) DeleteWorkerTail(ctx context.Context, rc *ResourceContainer, scriptName, tailID string) error {
+	if rc.Level != AccountRouteLevel {
+		return ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return ErrMissingAccountID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/workers/scripts/%s/tails/%s", rc.Identifier, scriptName, tailID)
+	res, err := api.makeRequestContext(ctx, http.MethodDelete, uri, nil)
+	if err != nil {
+		return err
+	}
+
+	var r WorkerTailResponse
+	if err := json.Unmarshal(res, &r); err != nil {
+		return fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return nil
+}
+
+// TailStatusRange filters tail events to HTTP status codes in the inclusive
+// range [From, To].
+type TailStatusRange struct {
+	From int `json:"from,omitempty"`
+	To   int `json:"to,omitempty"`
+}
+
+// TailFilters narrows down which invocations a tail session streams events
+// for. Filters are supplied once, when the session is created with
+// CreateWorkerTail or StreamWorkerTail, and apply for the life of the
+// session.
+type TailFilters struct {
+	ClientIPs    []string          `json:"client_ip,omitempty"`
+	Methods      []string          `json:"method,omitempty"`
+	SamplingRate *float64          `json:"sampling_rate,omitempty"`
+	StatusRanges []TailStatusRange `json:"status_code,omitempty"`
+	Outcomes     []string          `json:"outcome,omitempty"`
+}
+
+// TailEventException is a single uncaught exception captured during an
+// invocation.
+type TailEventException struct {
+	Name      string `json:"name"`
+	Message   string `json:"message"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+// TailEventLog is a single console log line captured during an invocation.
+type TailEventLog struct {
+	Message   []interface{} `json:"message"`
+	Level     string        `json:"level"`
+	Timestamp int64         `json:"timestamp"`
+}
+
+// TailEvent is a single streamed invocation record, as decoded from a live
+// tail websocket connection.
+type TailEvent struct {
+	Outcome        string               `json:"outcome"`
+	ScriptName     string               `json:"scriptName"`
+	Exceptions     []TailEventException `json:"exceptions"`
+	Logs           []TailEventLog       `json:"logs"`
+	EventTimestamp int64                `json:"eventTimestamp"`
+	Event          json.RawMessage      `json:"event"`
+}
+
+// StreamWorkerTail opens a live-log tail session for a worker script scoped
+// to the given filters, dials its websocket URL, and calls handler for
+// every TailEvent received until ctx is cancelled, the connection drops, or
+// a message fails to decode as a TailEvent.
+func (api *API) StreamWorkerTail(ctx context.Context, rc *ResourceContainer, scriptName string, filters TailFilters, handler func(TailEvent)) error {
+	tail, err := api.CreateWorkerTail(ctx, rc, scriptName, filters)
+	if err != nil {
+		return fmt.Errorf("could not create worker tail: %w", err)
+	}
+	defer func() {
+		deleteCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		api.DeleteWorkerTail(deleteCtx, rc, scriptName, tail.ID)
+	}()
+
+	header := http.Header{}
+	header.Set("Sec-WebSocket-Protocol", "trace-v1")
+	conn, err := dialWebsocket(ctx, tail.URL, header)
+	if err != nil {
+		return fmt.Errorf("could not dial worker tail websocket: %w", err)
+	}
+	defer conn.Close()
+
+	// conn.ReadMessage blocks with no deadline of its own, so close the
+	// connection out from under it as soon as ctx is cancelled.
+	go func() {
+		<-ctx.Done()
+		conn.Close()
+	}()
+
+	for {
+		message, err := conn.ReadMessage()
+		if err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return ctxErr
+			}
+			return fmt.Errorf("worker tail websocket closed: %w", err)
+		}
+
+		var event TailEvent
+		if err := json.Unmarshal(message, &event); err != nil {
+			return fmt.Errorf("%s: %w", errUnmarshalError, err)
+		}
+		handler(event)
+	}
+}
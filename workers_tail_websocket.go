@@ -0,0 +1,279 @@
+package cloudflare
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// websocketGUID is the magic value RFC 6455 defines for deriving
+// Sec-WebSocket-Accept from the client's Sec-WebSocket-Key.
+const websocketGUID = "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"
+
+const (
+	websocketOpContinuation = 0x0
+	websocketOpText         = 0x1
+	websocketOpClose        = 0x8
+	websocketOpPing         = 0x9
+	websocketOpPong         = 0xa
+)
+
+// websocketConn is a minimal RFC 6455 client, just enough to dial the
+// worker tail endpoint, send single-frame messages and read (possibly
+// fragmented) data messages. It exists so tail streaming doesn't pull in a
+// third-party websocket dependency.
+type websocketConn struct {
+	conn net.Conn
+	br   *bufio.Reader
+}
+
+// dialWebsocket performs the HTTP Upgrade handshake against a ws:// or
+// wss:// URL and returns a connection ready for text message exchange.
+func dialWebsocket(ctx context.Context, rawURL string, header http.Header) (*websocketConn, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	var useTLS bool
+	switch u.Scheme {
+	case "ws":
+		useTLS = false
+	case "wss":
+		useTLS = true
+	default:
+		return nil, fmt.Errorf("unsupported worker tail scheme %q", u.Scheme)
+	}
+
+	host := u.Host
+	if !strings.Contains(host, ":") {
+		if useTLS {
+			host += ":443"
+		} else {
+			host += ":80"
+		}
+	}
+
+	var d net.Dialer
+	var conn net.Conn
+	if useTLS {
+		conn, err = (&tls.Dialer{NetDialer: &d}).DialContext(ctx, "tcp", host)
+	} else {
+		conn, err = d.DialContext(ctx, "tcp", host)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	keyBytes := make([]byte, 16)
+	if _, err := rand.Read(keyBytes); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	key := base64.StdEncoding.EncodeToString(keyBytes)
+
+	path := u.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+	if u.RawQuery != "" {
+		path += "?" + u.RawQuery
+	}
+
+	var req bytes.Buffer
+	fmt.Fprintf(&req, "GET %s HTTP/1.1\r\n", path)
+	fmt.Fprintf(&req, "Host: %s\r\n", u.Host)
+	req.WriteString("Upgrade: websocket\r\n")
+	req.WriteString("Connection: Upgrade\r\n")
+	fmt.Fprintf(&req, "Sec-WebSocket-Key: %s\r\n", key)
+	req.WriteString("Sec-WebSocket-Version: 13\r\n")
+	for name, values := range header {
+		for _, v := range values {
+			fmt.Fprintf(&req, "%s: %s\r\n", name, v)
+		}
+	}
+	req.WriteString("\r\n")
+
+	if _, err := conn.Write(req.Bytes()); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	br := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(br, nil)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusSwitchingProtocols {
+		conn.Close()
+		return nil, fmt.Errorf("worker tail websocket handshake failed: %s", resp.Status)
+	}
+
+	h := sha1.New()
+	h.Write([]byte(key + websocketGUID))
+	expectedAccept := base64.StdEncoding.EncodeToString(h.Sum(nil))
+	if resp.Header.Get("Sec-WebSocket-Accept") != expectedAccept {
+		conn.Close()
+		return nil, errors.New("worker tail websocket handshake failed: invalid Sec-WebSocket-Accept")
+	}
+
+	return &websocketConn{conn: conn, br: br}, nil
+}
+
+// WriteMessage sends a single, unfragmented, client-masked frame.
+func (c *websocketConn) WriteMessage(opcode byte, payload []byte) error {
+	var frame bytes.Buffer
+	frame.WriteByte(0x80 | opcode) // FIN + opcode, never fragmented
+
+	length := len(payload)
+	switch {
+	case length <= 125:
+		frame.WriteByte(0x80 | byte(length)) // mask bit + length
+	case length <= 65535:
+		frame.WriteByte(0x80 | 126)
+		binary.Write(&frame, binary.BigEndian, uint16(length))
+	default:
+		frame.WriteByte(0x80 | 127)
+		binary.Write(&frame, binary.BigEndian, uint64(length))
+	}
+
+	var maskKey [4]byte
+	if _, err := rand.Read(maskKey[:]); err != nil {
+		return err
+	}
+	frame.Write(maskKey[:])
+
+	masked := make([]byte, length)
+	for i, b := range payload {
+		masked[i] = b ^ maskKey[i%4]
+	}
+	frame.Write(masked)
+
+	_, err := c.conn.Write(frame.Bytes())
+	return err
+}
+
+// ReadMessage returns the payload of the next complete data message,
+// transparently reassembling fragmented frames and answering pings.
+func (c *websocketConn) ReadMessage() ([]byte, error) {
+	for {
+		opcode, payload, fin, err := c.readFrame()
+		if err != nil {
+			return nil, err
+		}
+
+		switch opcode {
+		case websocketOpPing:
+			if err := c.WriteMessage(websocketOpPong, payload); err != nil {
+				return nil, err
+			}
+			continue
+		case websocketOpPong:
+			continue
+		case websocketOpClose:
+			return nil, io.EOF
+		}
+
+		if fin {
+			return payload, nil
+		}
+
+		// Fragmented message: keep reading continuation frames until FIN.
+		// RFC 6455 §5.4 allows control frames (ping/pong/close) to be
+		// interleaved between the fragments of a data message, so those
+		// still need handling here rather than being treated as more data.
+		message := payload
+		for !fin {
+			contOpcode, cont, contFin, err := c.readFrame()
+			if err != nil {
+				return nil, err
+			}
+
+			switch contOpcode {
+			case websocketOpPing:
+				if err := c.WriteMessage(websocketOpPong, cont); err != nil {
+					return nil, err
+				}
+				continue
+			case websocketOpPong:
+				continue
+			case websocketOpClose:
+				return nil, io.EOF
+			}
+
+			message = append(message, cont...)
+			fin = contFin
+		}
+		return message, nil
+	}
+}
+
+// readFrame reads a single websocket frame off the wire.
+func (c *websocketConn) readFrame() (opcode byte, payload []byte, fin bool, err error) {
+	first, err := c.br.ReadByte()
+	if err != nil {
+		return 0, nil, false, err
+	}
+	second, err := c.br.ReadByte()
+	if err != nil {
+		return 0, nil, false, err
+	}
+
+	fin = first&0x80 != 0
+	opcode = first & 0x0f
+	masked := second&0x80 != 0
+	length := int64(second & 0x7f)
+
+	switch length {
+	case 126:
+		var ext [2]byte
+		if _, err := io.ReadFull(c.br, ext[:]); err != nil {
+			return 0, nil, false, err
+		}
+		length = int64(binary.BigEndian.Uint16(ext[:]))
+	case 127:
+		var ext [8]byte
+		if _, err := io.ReadFull(c.br, ext[:]); err != nil {
+			return 0, nil, false, err
+		}
+		length = int64(binary.BigEndian.Uint64(ext[:]))
+	}
+
+	var maskKey [4]byte
+	if masked {
+		if _, err := io.ReadFull(c.br, maskKey[:]); err != nil {
+			return 0, nil, false, err
+		}
+	}
+
+	payload = make([]byte, length)
+	if _, err := io.ReadFull(c.br, payload); err != nil {
+		return 0, nil, false, err
+	}
+	if masked {
+		for i := range payload {
+			payload[i] ^= maskKey[i%4]
+		}
+	}
+
+	return opcode, payload, fin, nil
+}
+
+func (c *websocketConn) Close() error {
+	return c.conn.Close()
+}
@@ -0,0 +1,338 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"mime/multipart"
+	"net/http"
+
+	"github.com/goccy/go-json"
+)
+
+// percentageTotalTolerance absorbs the binary floating-point rounding that
+// legitimate splits (e.g. three ways at 33.33/33.33/33.34) accumulate when
+// summed, without accepting meaningfully incomplete traffic splits.
+const percentageTotalTolerance = 1e-9
+
+// WorkerVersion is a single, immutable version of a worker script, created
+// ahead of a gradual deployment by CreateWorkerVersion.
+type WorkerVersion struct {
+	ID          string                 `json:"id,omitempty"`
+	Number      int                    `json:"number,omitempty"`
+	Metadata    WorkerMetaData         `json:"metadata,omitempty"`
+	Annotations map[string]string      `json:"annotations,omitempty"`
+	Resources   WorkerVersionResources `json:"resources,omitempty"`
+}
+
+// WorkerVersionResources describes the bindings and script attached to a
+// WorkerVersion.
+type WorkerVersionResources struct {
+	Script   WorkerVersionScript `json:"script,omitempty"`
+	Bindings []workerBindingMeta `json:"bindings,omitempty"`
+}
+
+// WorkerVersionScript identifies the script content backing a WorkerVersion.
+type WorkerVersionScript struct {
+	Etag             string `json:"etag,omitempty"`
+	HandlerName      string `json:"handler,omitempty"`
+	LastDeployedFrom string `json:"last_deployed_from,omitempty"`
+}
+
+// VersionTraffic assigns a percentage of a worker's traffic to a single
+// WorkerVersion as part of a WorkerDeployment.
+type VersionTraffic struct {
+	VersionID  string  `json:"version_id"`
+	Percentage float64 `json:"percentage"`
+}
+
+// WorkerDeployment splits a worker's traffic across one or more versions,
+// enabling gradual rollouts and instant rollbacks without a full re-upload.
+type WorkerDeployment struct {
+	ID       string           `json:"id,omitempty"`
+	Strategy string           `json:"strategy,omitempty"`
+	Versions []VersionTraffic `json:"versions"`
+}
+
+// WorkerVersionResponse wrapper struct for API response to a single worker
+// version call.
+type WorkerVersionResponse struct {
+	Response
+	Result WorkerVersion `json:"result"`
+}
+
+// WorkerVersionListResponse wrapper struct for API response to the worker
+// version list call.
+type WorkerVersionListResponse struct {
+	Response
+	ResultInfo
+	Result []WorkerVersion `json:"result"`
+}
+
+// WorkerDeploymentResponse wrapper struct for API response to a single
+// worker deployment call.
+type WorkerDeploymentResponse struct {
+	Response
+	Result WorkerDeployment `json:"result"`
+}
+
+// WorkerDeploymentListResponse wrapper struct for API response to the
+// worker deployment list call.
+type WorkerDeploymentListResponse struct {
+	Response
+	Result []WorkerDeployment `json:"result"`
+}
+
+// CreateWorkerVersionParams provides the parameters for CreateWorkerVersion.
+// It mirrors the subset of CreateWorkerParams relevant to a single version.
+type CreateWorkerVersionParams struct {
+	ScriptName string
+	Script     interface{}
+
+	// Module changes the Content-Type header to specify the script is an
+	// ES Module syntax script.
+	Module bool
+
+	// Bindings should be a map where the keys are the binding name, and the
+	// values are the binding content
+	Bindings map[string]WorkerBinding
+
+	// CompatibilityDate is a date in the form yyyy-mm-dd,
+	// which will be used to determine which version of the Workers runtime is used.
+	//  https://developers.cloudflare.com/workers/platform/compatibility-dates/
+	CompatibilityDate string
+
+	// CompatibilityFlags are the names of features of the Workers runtime to be enabled or disabled,
+	// usually used together with CompatibilityDate.
+	//  https://developers.cloudflare.com/workers/platform/compatibility-dates/#compatibility-flags
+	CompatibilityFlags []string
+
+	Placement *Placement
+
+	// Annotations are free-form key/value metadata attached to the version,
+	// e.g. a commit hash or release note.
+	Annotations map[string]string
+}
+
+// CreateWorkerVersion uploads a new, immutable WorkerVersion without routing
+// any traffic to it. Call CreateWorkerDeployment to shift traffic onto it.
+//
+// API reference: https://developers.cloudflare.com/api/operations/worker-versions-create-version
+func (api *API) CreateWorkerVersion(ctx context.Context, rc *ResourceContainer, params CreateWorkerVersionParams) (WorkerVersion, error) {
+	if rc.Level != AccountRouteLevel {
+		return WorkerVersion{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return WorkerVersion{}, ErrMissingAccountID
+	}
+
+	cwp := CreateWorkerParams{
+		ScriptName:         params.ScriptName,
+		Script:             params.Script,
+		Module:             params.Module,
+		Bindings:           params.Bindings,
+		CompatibilityDate:  params.CompatibilityDate,
+		CompatibilityFlags: params.CompatibilityFlags,
+		Placement:          params.Placement,
+		Annotations:        params.Annotations,
+	}
+
+	r, w := io.Pipe()
+	mpw := multipart.NewWriter(w)
+	contentType := mpw.FormDataContentType()
+	mpChan := make(chan error)
+	go func() {
+		defer w.Close()
+		_, _, _, err := formatMultipartBody(ctx, api, rc, cwp, mpw)
+		mpChan <- err
+	}()
+
+	headers := make(http.Header)
+	headers.Set("Content-Type", contentType)
+
+	uri := fmt.Sprintf("/accounts/%s/workers/scripts/%s/versions", rc.Identifier, params.ScriptName)
+	var resp *WorkerVersionResponse
+	doneCh := make(chan error)
+	go func() {
+		res, err := api.makeRequestContextWithHeaders(ctx, http.MethodPost, uri, r, headers)
+		if err != nil {
+			doneCh <- err
+			return
+		}
+		if err := json.Unmarshal(res, &resp); err != nil {
+			doneCh <- fmt.Errorf("%s: %w", errUnmarshalError, err)
+			return
+		}
+		doneCh <- nil
+	}()
+
+	if err := <-mpChan; err != nil {
+		return WorkerVersion{}, err
+	}
+	if err := <-doneCh; err != nil {
+		return WorkerVersion{}, err
+	}
+
+	return resp.Result, nil
+}
+
+// ListWorkerVersions returns the versions uploaded for a worker script.
+//
+// API reference: https://developers.cloudflare.com/api/operations/worker-versions-list-versions
+func (api *API) ListWorkerVersions(ctx context.Context, rc *ResourceContainer, scriptName string) (WorkerVersionListResponse, error) {
+	if rc.Level != AccountRouteLevel {
+		return WorkerVersionListResponse{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return WorkerVersionListResponse{}, ErrMissingAccountID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/workers/scripts/%s/versions", rc.Identifier, scriptName)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return WorkerVersionListResponse{}, err
+	}
+
+	var r WorkerVersionListResponse
+	if err := json.Unmarshal(res, &r); err != nil {
+		return WorkerVersionListResponse{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r, nil
+}
+
+// GetWorkerVersion returns a single worker version by ID.
+//
+// API reference: https://developers.cloudflare.com/api/operations/worker-versions-get-version-detail
+func (api *API) GetWorkerVersion(ctx context.Context, rc *ResourceContainer, scriptName, versionID string) (WorkerVersion, error) {
+	if rc.Level != AccountRouteLevel {
+		return WorkerVersion{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return WorkerVersion{}, ErrMissingAccountID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/workers/scripts/%s/versions/%s", rc.Identifier, scriptName, versionID)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return WorkerVersion{}, err
+	}
+
+	var r WorkerVersionResponse
+	if err := json.Unmarshal(res, &r); err != nil {
+		return WorkerVersion{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result, nil
+}
+
+// CreateWorkerDeploymentParams provides the parameters for
+// CreateWorkerDeployment. Versions must sum to 100 percent.
+type CreateWorkerDeploymentParams struct {
+	ScriptName string
+	Strategy   string
+	Versions   []VersionTraffic
+}
+
+// CreateWorkerDeployment shifts live traffic for a worker script across one
+// or more previously created versions, enabling gradual rollouts and
+// instant rollbacks without a full re-upload.
+//
+// API reference: https://developers.cloudflare.com/api/operations/worker-deployments-create-deployment
+func (api *API) CreateWorkerDeployment(ctx context.Context, rc *ResourceContainer, params CreateWorkerDeploymentParams) (WorkerDeployment, error) {
+	if rc.Level != AccountRouteLevel {
+		return WorkerDeployment{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return WorkerDeployment{}, ErrMissingAccountID
+	}
+
+	var total float64
+	for _, v := range params.Versions {
+		total += v.Percentage
+	}
+	if math.Abs(total-100) > percentageTotalTolerance {
+		return WorkerDeployment{}, fmt.Errorf("deployment version percentages must sum to 100, got %v", total)
+	}
+
+	body, err := json.Marshal(struct {
+		Strategy string           `json:"strategy,omitempty"`
+		Versions []VersionTraffic `json:"versions"`
+	}{
+		Strategy: params.Strategy,
+		Versions: params.Versions,
+	})
+	if err != nil {
+		return WorkerDeployment{}, err
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/workers/scripts/%s/deployments", rc.Identifier, params.ScriptName)
+	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, body)
+	if err != nil {
+		return WorkerDeployment{}, err
+	}
+
+	var r WorkerDeploymentResponse
+	if err := json.Unmarshal(res, &r); err != nil {
+		return WorkerDeployment{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result, nil
+}
+
+// ListWorkerDeployments returns the deployment history for a worker script.
+//
+// API reference: https://developers.cloudflare.com/api/operations/worker-deployments-list-deployments
+func (api *API) ListWorkerDeployments(ctx context.Context, rc *ResourceContainer, scriptName string) (WorkerDeploymentListResponse, error) {
+	if rc.Level != AccountRouteLevel {
+		return WorkerDeploymentListResponse{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return WorkerDeploymentListResponse{}, ErrMissingAccountID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/workers/scripts/%s/deployments", rc.Identifier, scriptName)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return WorkerDeploymentListResponse{}, err
+	}
+
+	var r WorkerDeploymentListResponse
+	if err := json.Unmarshal(res, &r); err != nil {
+		return WorkerDeploymentListResponse{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r, nil
+}
+
+// GetWorkerDeployment returns a single deployment by ID.
+//
+// API reference: https://developers.cloudflare.com/api/operations/worker-deployments-get-deployment-detail
+func (api *API) GetWorkerDeployment(ctx context.Context, rc *ResourceContainer, scriptName, deploymentID string) (WorkerDeployment, error) {
+	if rc.Level != AccountRouteLevel {
+		return WorkerDeployment{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return WorkerDeployment{}, ErrMissingAccountID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/workers/scripts/%s/deployments/%s", rc.Identifier, scriptName, deploymentID)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return WorkerDeployment{}, err
+	}
+
+	var r WorkerDeploymentResponse
+	if err := json.Unmarshal(res, &r); err != nil {
+		return WorkerDeployment{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result, nil
+}